@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/vascocosta/owm"
+	"log"
+)
+
+func main() {
+	// Create a new Client given an API key.
+	c := owm.NewClient("YOUR_OPEN_WEATHER_MAP_API_KEY")
+	// Decode the current air quality of a location given its coordinates.
+	// AirPollution returns an AirPollution.
+	ap, err := c.AirPollution(38.72, -9.13)
+	// If there is an error, print it and terminate the program.
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Print a string representation of ap using the Stringer interface, and
+	// the component with the highest normalized concentration.
+	fmt.Println(&ap)
+	fmt.Println("Dominant pollutant:", ap.DominantPollutant())
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"github.com/vascocosta/owm"
+	"log"
+)
+
+func main() {
+	// Create a new Client given an API key.
+	c := owm.NewClient("YOUR_OPEN_WEATHER_MAP_API_KEY")
+	// Resolve a city name to up to 5 matching Locations. Geocode returns a
+	// []Location.
+	locs, err := c.Geocode("Lisbon", 5)
+	// If there is an error, print it and terminate the program.
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Print a string representation of each Location using the Stringer
+	// interface.
+	for i := range locs {
+		fmt.Println(&locs[i])
+	}
+}
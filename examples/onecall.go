@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"github.com/vascocosta/owm"
+	"log"
+)
+
+func main() {
+	// Create a new Client given an API key.
+	c := owm.NewClient("YOUR_OPEN_WEATHER_MAP_API_KEY")
+	// Decode the current weather, minutely, hourly, daily forecast and any
+	// active alerts of a location given its coordinates, units and
+	// language, in a single web API call. OneCall returns a OneCall.
+	oc, err := c.OneCall(38.72, -9.13, "metric", "en")
+	// If there is an error, print it and terminate the program.
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Print the current temperature and any active alerts.
+	fmt.Println("Current temp:", oc.Current.Temp)
+	for i := range oc.Alerts {
+		fmt.Println("Alert:", oc.Alerts[i].Event)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"github.com/vascocosta/owm"
+	"log"
+)
+
+func main() {
+	// Create a new Client given an API key.
+	c := owm.NewClient("YOUR_OPEN_WEATHER_MAP_API_KEY")
+	// Decode the current weather of a location given a free-form place query
+	// and units. WeatherByPlace geocodes query once and delegates to
+	// WeatherByCoord, so it works without relying on OWM's deprecated city
+	// name lookup. WeatherByPlace returns a Weather.
+	w, err := c.WeatherByPlace("Lisbon,PT", "metric")
+	// If there is an error, print it and terminate the program.
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Print a string representation of w using the Stringer interface.
+	fmt.Println(&w)
+}
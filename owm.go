@@ -8,13 +8,19 @@
 package owm
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -194,82 +200,859 @@ func (f *Forecast) String() string {
 		f.Forecast)
 }
 
+// OneCall represents the combined current, minutely, hourly and daily
+// weather together with any active alerts for a specific location, as
+// returned by the One Call API.
+//
+// It is returned by the OneCall method of Client.
+type OneCall struct {
+	Lat            float64 `json:"lat"`             // Location latitude.
+	Lon            float64 `json:"lon"`             // Location longitude.
+	Timezone       string  `json:"timezone"`        // Timezone name for the location.
+	TimezoneOffset int     `json:"timezone_offset"` // Shift in seconds from UTC.
+	Current        struct {
+		Dt         int     `json:"dt"`         // Current data unix timestamp.
+		Sunrise    int     `json:"sunrise"`    // Sunrise unix timestamp.
+		Sunset     int     `json:"sunset"`     // Sunset unix timestamp.
+		Temp       float64 `json:"temp"`       // Temperature.
+		FeelsLike  float64 `json:"feels_like"` // Human perception of temperature.
+		Pressure   float64 `json:"pressure"`   // Atmospheric pressure.
+		Humidity   int     `json:"humidity"`   // Humidity.
+		DewPoint   float64 `json:"dew_point"`  // Dew point temperature.
+		Uvi        float64 `json:"uvi"`        // UV index.
+		Clouds     int     `json:"clouds"`     // Cloudiness.
+		Visibility int     `json:"visibility"` // Average visibility in metres.
+		WindSpeed  float64 `json:"wind_speed"` // Wind speed.
+		WindDeg    float64 `json:"wind_deg"`   // Wind direction.
+		WindGust   float64 `json:"wind_gust"`  // Wind gust.
+		Weather    []struct {
+			Id          int    `json:"id"`
+			Main        string `json:"main"`
+			Description string `json:"description"`
+			Icon        string `json:"icon"`
+		} `json:"weather"`
+		Rain struct {
+			OneH float64 `json:"1h"` // Rain volume for the last hour.
+		} `json:"rain"`
+		Snow struct {
+			OneH float64 `json:"1h"` // Snow volume for the last hour.
+		} `json:"snow"`
+	} `json:"current"`
+	Minutely []struct {
+		Dt            int     `json:"dt"`            // Data unix timestamp.
+		Precipitation float64 `json:"precipitation"` // Precipitation volume.
+	} `json:"minutely"`
+	Hourly []struct {
+		Dt         int     `json:"dt"`
+		Temp       float64 `json:"temp"`
+		FeelsLike  float64 `json:"feels_like"`
+		Pressure   float64 `json:"pressure"`
+		Humidity   int     `json:"humidity"`
+		DewPoint   float64 `json:"dew_point"`
+		Uvi        float64 `json:"uvi"`
+		Clouds     int     `json:"clouds"`
+		Visibility int     `json:"visibility"`
+		WindSpeed  float64 `json:"wind_speed"`
+		WindDeg    float64 `json:"wind_deg"`
+		WindGust   float64 `json:"wind_gust"`
+		Pop        float64 `json:"pop"` // Probability of precipitation.
+		Weather    []struct {
+			Id          int    `json:"id"`
+			Main        string `json:"main"`
+			Description string `json:"description"`
+			Icon        string `json:"icon"`
+		} `json:"weather"`
+		Rain struct {
+			OneH float64 `json:"1h"`
+		} `json:"rain"`
+		Snow struct {
+			OneH float64 `json:"1h"`
+		} `json:"snow"`
+	} `json:"hourly"`
+	Daily []struct {
+		Dt        int     `json:"dt"`
+		Sunrise   int     `json:"sunrise"`
+		Sunset    int     `json:"sunset"`
+		Moonrise  int     `json:"moonrise"`
+		Moonset   int     `json:"moonset"`
+		MoonPhase float64 `json:"moon_phase"`
+		Temp      struct {
+			Day   float64 `json:"day"`
+			Min   float64 `json:"min"`
+			Max   float64 `json:"max"`
+			Night float64 `json:"night"`
+			Eve   float64 `json:"eve"`
+			Morn  float64 `json:"morn"`
+		} `json:"temp"`
+		FeelsLike struct {
+			Day   float64 `json:"day"`
+			Night float64 `json:"night"`
+			Eve   float64 `json:"eve"`
+			Morn  float64 `json:"morn"`
+		} `json:"feels_like"`
+		Pressure  float64 `json:"pressure"`
+		Humidity  int     `json:"humidity"`
+		DewPoint  float64 `json:"dew_point"`
+		WindSpeed float64 `json:"wind_speed"`
+		WindDeg   float64 `json:"wind_deg"`
+		WindGust  float64 `json:"wind_gust"`
+		Weather   []struct {
+			Id          int    `json:"id"`
+			Main        string `json:"main"`
+			Description string `json:"description"`
+			Icon        string `json:"icon"`
+		} `json:"weather"`
+		Clouds int     `json:"clouds"`
+		Pop    float64 `json:"pop"`
+		Rain   float64 `json:"rain"`
+		Snow   float64 `json:"snow"`
+		Uvi    float64 `json:"uvi"`
+	} `json:"daily"`
+	Alerts []struct {
+		SenderName  string   `json:"sender_name"` // Name of the alert source.
+		Event       string   `json:"event"`       // Alert event name.
+		Start       int      `json:"start"`       // Alert start unix timestamp.
+		End         int      `json:"end"`         // Alert end unix timestamp.
+		Description string   `json:"description"` // Alert description.
+		Tags        []string `json:"tags"`        // Type of severe weather.
+	} `json:"alerts"`
+}
+
+// AirPollution represents air quality data for a specific location, as
+// returned by the AirPollution, AirPollutionForecast and AirPollutionHistory
+// methods of Client.
+type AirPollution struct {
+	Coord struct {
+		Lon float64 `json:"lon"` // Location longitude.
+		Lat float64 `json:"lat"` // Location latitude.
+	} `json:"coord"`
+	List []struct {
+		Dt   int `json:"dt"` // Data unix timestamp.
+		Main struct {
+			AQI int `json:"aqi"` // Air Quality Index, 1 (Good) to 5 (Very Poor).
+		} `json:"main"`
+		Components struct {
+			CO    float64 `json:"co"`    // Carbon monoxide, μg/m3.
+			NO    float64 `json:"no"`    // Nitrogen monoxide, μg/m3.
+			NO2   float64 `json:"no2"`   // Nitrogen dioxide, μg/m3.
+			O3    float64 `json:"o3"`    // Ozone, μg/m3.
+			SO2   float64 `json:"so2"`   // Sulphur dioxide, μg/m3.
+			PM2_5 float64 `json:"pm2_5"` // Fine particulate matter, μg/m3.
+			PM10  float64 `json:"pm10"`  // Coarse particulate matter, μg/m3.
+			NH3   float64 `json:"nh3"`   // Ammonia, μg/m3.
+		} `json:"components"`
+	} `json:"list"`
+}
+
+// aqiLabels maps an OWM Air Quality Index value to the label OWM documents
+// for it.
+var aqiLabels = map[int]string{
+	1: "Good",
+	2: "Fair",
+	3: "Moderate",
+	4: "Poor",
+	5: "Very Poor",
+}
+
+// String returns a string representation of the first entry of AirPollution
+// by implementing Stringer.
+func (a *AirPollution) String() string {
+	if len(a.List) == 0 {
+		return "owm: no air pollution data"
+	}
+	e := a.List[0]
+	return fmt.Sprintf("Date: %v\n"+
+		"AQI: %v (%v)\n"+
+		"CO: %v\n"+
+		"NO: %v\n"+
+		"NO2: %v\n"+
+		"O3: %v\n"+
+		"SO2: %v\n"+
+		"PM2.5: %v\n"+
+		"PM10: %v\n"+
+		"NH3: %v",
+		time.Unix(int64(e.Dt), 0),
+		e.Main.AQI,
+		aqiLabels[e.Main.AQI],
+		e.Components.CO,
+		e.Components.NO,
+		e.Components.NO2,
+		e.Components.O3,
+		e.Components.SO2,
+		e.Components.PM2_5,
+		e.Components.PM10,
+		e.Components.NH3)
+}
+
+// pollutantReferences holds, for each pollutant component, a reference
+// concentration (in μg/m3). For CO, NO2, O3, SO2, PM2.5 and PM10 it is the
+// concentration OWM's AQI scale treats as the top of its "Poor" band; NO and
+// NH3 are not graded by that scale and get references of their own. They are
+// used only to make components with very different natural scales (CO is
+// reported in the thousands, O3 in the tens) comparable to each other, not as
+// an absolute health standard.
+var pollutantReferences = []struct {
+	name string
+	get  func(a *AirPollution) float64
+	ref  float64
+}{
+	{"CO", func(a *AirPollution) float64 { return a.List[0].Components.CO }, 15400},
+	{"NO", func(a *AirPollution) float64 { return a.List[0].Components.NO }, 400},
+	{"NO2", func(a *AirPollution) float64 { return a.List[0].Components.NO2 }, 200},
+	{"O3", func(a *AirPollution) float64 { return a.List[0].Components.O3 }, 180},
+	{"SO2", func(a *AirPollution) float64 { return a.List[0].Components.SO2 }, 350},
+	{"PM2.5", func(a *AirPollution) float64 { return a.List[0].Components.PM2_5 }, 75},
+	{"PM10", func(a *AirPollution) float64 { return a.List[0].Components.PM10 }, 200},
+	{"NH3", func(a *AirPollution) float64 { return a.List[0].Components.NH3 }, 200},
+}
+
+// DominantPollutant returns the name of the component (e.g. "PM2.5") whose
+// concentration, normalized against pollutantReferences, is the highest of
+// the first entry of a. It returns an empty string if a has no entries.
+func (a *AirPollution) DominantPollutant() string {
+	if len(a.List) == 0 {
+		return ""
+	}
+	name := pollutantReferences[0].name
+	max := pollutantReferences[0].get(a) / pollutantReferences[0].ref
+	for _, p := range pollutantReferences[1:] {
+		if ratio := p.get(a) / p.ref; ratio > max {
+			max, name = ratio, p.name
+		}
+	}
+	return name
+}
+
+// Location represents a named place resolved by the Geocoding API, as
+// returned by the Geocode and ReverseGeocode methods of Client.
+type Location struct {
+	Name       string            `json:"name"`        // Location name.
+	LocalNames map[string]string `json:"local_names"` // Location name keyed by language code.
+	Lat        float64           `json:"lat"`         // Location latitude.
+	Lon        float64           `json:"lon"`         // Location longitude.
+	Country    string            `json:"country"`     // Country code.
+	State      string            `json:"state"`       // State, where applicable.
+}
+
+// String returns a string representation of Location by implementing
+// Stringer.
+func (l *Location) String() string {
+	return fmt.Sprintf("Name: %v\n"+
+		"Country: %v\n"+
+		"State: %v\n"+
+		"Lat: %v\n"+
+		"Lon: %v",
+		l.Name,
+		l.Country,
+		l.State,
+		l.Lat,
+		l.Lon)
+}
+
+// ErrLocationNotFound is returned by WeatherByPlace and ForecastByPlace when
+// geocoding the given place yields no result.
+var ErrLocationNotFound = errors.New("owm: no location found for the given place")
+
+// APIError represents an error reported by the OWM web API itself, as
+// opposed to a transport or JSON decoding failure.
+type APIError struct {
+	HTTPStatus int    // HTTP status code of the response.
+	Code       string // The OWM "cod" field, normalized to a string.
+	Message    string // The OWM "message" field, if any.
+	Body       []byte // The raw response body.
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("owm: api error %s (http %d): %s", e.Code, e.HTTPStatus, e.Message)
+}
+
+// multiError joins the errors of a batched request, such as a chunked call to
+// WeatherByIds, so a caller can still inspect each individual failure via
+// errors.As while getting one error value back.
+type multiError struct {
+	errs []error
+}
+
+func (e *multiError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return "owm: " + strconv.Itoa(len(e.errs)) + " of the batched requests failed: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap gives errors.Is and errors.As access to every wrapped error.
+func (e *multiError) Unwrap() []error {
+	return e.errs
+}
+
+// joinErrors returns a single error wrapping every non-nil error in errs, or
+// nil if errs contains none.
+func joinErrors(errs []error) error {
+	var nonNil []error
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+	if len(nonNil) == 0 {
+		return nil
+	}
+	return &multiError{errs: nonNil}
+}
+
+// codeEnvelope decodes just enough of an OWM response to inspect its status.
+// OWM is inconsistent about the type of the "cod" field: some endpoints
+// encode it as a JSON string (e.g. Forecast.Cod), others as a JSON number
+// (e.g. Weather.Cod), so it is decoded via json.RawMessage first.
+type codeEnvelope struct {
+	Cod     json.RawMessage `json:"cod"`
+	Message string          `json:"message"`
+}
+
+func (e *codeEnvelope) code() string {
+	var s string
+	if json.Unmarshal(e.Cod, &s) == nil {
+		return s
+	}
+	var n int
+	if json.Unmarshal(e.Cod, &n) == nil {
+		return strconv.Itoa(n)
+	}
+	return ""
+}
+
+func newAPIError(status int, body []byte) *APIError {
+	var env codeEnvelope
+	_ = json.Unmarshal(body, &env)
+	return &APIError{
+		HTTPStatus: status,
+		Code:       env.code(),
+		Message:    env.Message,
+		Body:       body,
+	}
+}
+
+// bodyAPIError reports whether a response that arrived with a successful
+// HTTP status still carries an OWM error in its body. OWM is inconsistent
+// about surfacing failures as HTTP status codes: some endpoints have been
+// known to answer with HTTP 200 and an error recorded only in the body's
+// "cod"/"message" fields. It returns nil if body doesn't decode as an object
+// with a "cod" field, or if that field is absent or reports success.
+func bodyAPIError(status int, body []byte) *APIError {
+	var env codeEnvelope
+	if json.Unmarshal(body, &env) != nil {
+		return nil
+	}
+	code := env.code()
+	if code == "" || code == strconv.Itoa(status) {
+		return nil
+	}
+	if n, err := strconv.Atoi(code); err == nil {
+		status = n
+	}
+	return &APIError{
+		HTTPStatus: status,
+		Code:       code,
+		Message:    env.Message,
+		Body:       body,
+	}
+}
+
+// IsUnauthorized reports whether err is an *APIError caused by an invalid or
+// missing API key.
+func IsUnauthorized(err error) bool {
+	var ae *APIError
+	return errors.As(err, &ae) && ae.HTTPStatus == http.StatusUnauthorized
+}
+
+// IsNotFound reports whether err is an *APIError caused by an unknown city,
+// id or location.
+func IsNotFound(err error) bool {
+	var ae *APIError
+	return errors.As(err, &ae) && ae.HTTPStatus == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an *APIError caused by exceeding
+// OWM's call rate limit.
+func IsRateLimited(err error) bool {
+	var ae *APIError
+	return errors.As(err, &ae) && ae.HTTPStatus == http.StatusTooManyRequests
+}
+
+// Cache stores and retrieves raw API responses keyed by request URL, so a
+// Client can survive rate limits and brief offline periods.
+type Cache interface {
+	// Get returns the cached data for key, the time it was fetched, and
+	// whether an entry exists at all.
+	Get(key string) (data []byte, fetchedAt time.Time, ok bool)
+	// Set stores data for key, recording fetchedAt as its fetch time.
+	Set(key string, data []byte, fetchedAt time.Time)
+}
+
+// ErrStaleCache is returned alongside cached data when a network request
+// failed and the Client fell back to a cache entry older than its TTL.
+// Callers can check for it with errors.Is; the concrete error is actually a
+// *StaleCacheError, so errors.As also gives access to the entry's fetch time.
+var ErrStaleCache = errors.New("owm: serving stale cached response, network request failed")
+
+// StaleCacheError reports that a Client served a cached response older than
+// its TTL because the network request that would have refreshed it failed.
+// It wraps ErrStaleCache, so errors.Is(err, ErrStaleCache) still works.
+type StaleCacheError struct {
+	FetchedAt time.Time // When the stale response was originally cached.
+}
+
+func (e *StaleCacheError) Error() string {
+	return ErrStaleCache.Error() + ", last updated " + e.FetchedAt.String()
+}
+
+func (e *StaleCacheError) Unwrap() error {
+	return ErrStaleCache
+}
+
+// FileCache is a Cache backed by one JSON file per entry in Dir.
+type FileCache struct {
+	Dir string // Directory entries are stored in.
+}
+
+// NewFileCache returns a FileCache that stores entries under dir.
+func NewFileCache(dir string) *FileCache {
+	return &FileCache{Dir: dir}
+}
+
+type fileCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Data      []byte    `json:"data"`
+}
+
+func (fc *FileCache) path(key string) string {
+	return filepath.Join(fc.Dir, key+".json")
+}
+
+// Get implements Cache.
+func (fc *FileCache) Get(key string) (data []byte, fetchedAt time.Time, ok bool) {
+	raw, err := ioutil.ReadFile(fc.path(key))
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	var entry fileCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, time.Time{}, false
+	}
+	return entry.Data, entry.FetchedAt, true
+}
+
+// Set implements Cache.
+func (fc *FileCache) Set(key string, data []byte, fetchedAt time.Time) {
+	raw, err := json.Marshal(fileCacheEntry{FetchedAt: fetchedAt, Data: data})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(fc.Dir, 0o755); err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(fc.path(key), raw, 0o644)
+}
+
+// cacheKey derives a Cache key from a request URL.
+func cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
 // Client represents an OpenWeatherMap API client.
 type Client struct {
-	key     string // API key.
-	baseURL string // API base URL.
+	key            string        // API key.
+	baseURL        string        // API base URL.
+	oneCallBaseURL string        // One Call API base URL.
+	geoBaseURL     string        // Geocoding API base URL.
+	httpClient     *http.Client  // HTTP client used to perform requests.
+	retryN         int           // Number of retries on a failed request.
+	retryBackoff   time.Duration // Delay between retries.
+	lang           string        // Language of the response, e.g. "pt".
+	langErr        error         // Set by WithLanguage when given an unknown code.
+	cache          Cache         // Optional response cache.
+	cacheTTL       time.Duration // How long a cached response is considered fresh.
+	maxConcurrency int           // Max in-flight requests for a batched call such as WeatherByIds.
+}
+
+// defaultMaxConcurrency is the number of chunk requests WeatherByIds issues
+// in flight at once, unless overridden with WithMaxConcurrency.
+const defaultMaxConcurrency = 4
+
+// owmGroupMaxIDs is the maximum number of city ids the "group" endpoint
+// accepts in a single request. OWM does not document this in its API docs,
+// but it is documented as owmRequestSeveralCityId in the telegraf plugin.
+const owmGroupMaxIDs = 20
+
+// LanguageError reports that a language code passed to WithLanguage is not
+// part of the set OWM documents as supported.
+type LanguageError struct {
+	Code string // The unsupported language code.
+}
+
+func (e *LanguageError) Error() string {
+	return "owm: unsupported language code: " + e.Code
+}
+
+// supportedLanguages is the set of language codes documented by OWM for
+// localizing weather.description, as used by WithLanguage.
+var supportedLanguages = map[string]bool{
+	"af": true, "al": true, "ar": true, "az": true, "bg": true, "ca": true,
+	"cz": true, "da": true, "de": true, "el": true, "en": true, "eu": true,
+	"fa": true, "fi": true, "fr": true, "gl": true, "he": true, "hi": true,
+	"hr": true, "hu": true, "id": true, "it": true, "ja": true, "kr": true,
+	"la": true, "lt": true, "mk": true, "no": true, "nl": true, "pl": true,
+	"pt": true, "pt_br": true, "ro": true, "ru": true, "sv": true, "se": true,
+	"sk": true, "sl": true, "sp": true, "es": true, "sr": true, "th": true,
+	"tr": true, "ua": true, "uk": true, "vi": true, "zh_cn": true, "zh_tw": true,
+	"zu": true,
+}
+
+// Option configures a Client created via NewClientWithOptions.
+type Option func(*Client)
+
+// WithHTTPClient sets the *http.Client used to perform requests, letting
+// callers configure transports, proxies or cookie jars of their own.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithBaseURL overrides the API base URL, which is useful to target a mock
+// server in tests or a regional API mirror. It only affects the endpoints
+// under the data API, i.e. it does not affect OneCall, Geocode or
+// ReverseGeocode; use WithOneCallBaseURL and WithGeoBaseURL for those.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = url
+	}
+}
+
+// WithOneCallBaseURL overrides the base URL the OneCall method targets,
+// which is useful to target a mock server in tests. The One Call API lives
+// under its own API version, so it is not affected by WithBaseURL.
+func WithOneCallBaseURL(url string) Option {
+	return func(c *Client) {
+		c.oneCallBaseURL = url
+	}
+}
+
+// WithGeoBaseURL overrides the base URL the Geocode and ReverseGeocode
+// methods target, which is useful to target a mock server in tests. The
+// Geocoding API lives under its own path, so it is not affected by
+// WithBaseURL.
+func WithGeoBaseURL(url string) Option {
+	return func(c *Client) {
+		c.geoBaseURL = url
+	}
+}
+
+// WithTimeout sets a timeout on the Client's HTTP client, so a hung TCP
+// connect or a stalled response no longer blocks a caller forever.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.httpClient.Timeout = d
+	}
+}
+
+// WithRetry makes requests retry up to n times, waiting backoff between
+// attempts, before giving up and returning an error. Negative n is treated as
+// 0, i.e. no retries.
+func WithRetry(n int, backoff time.Duration) Option {
+	return func(c *Client) {
+		if n < 0 {
+			n = 0
+		}
+		c.retryN = n
+		c.retryBackoff = backoff
+	}
+}
+
+// WithLanguage sets the language of the response, so that fields such as
+// weather.description come back localized. code must be one of the codes
+// OWM documents, e.g. "pt", "fr" or "zh_cn". An unknown code is not rejected
+// immediately, since Option values can't return an error, but is instead
+// surfaced as a LanguageError from every WeatherBy*, ForecastBy* and OneCall
+// request (OneCall only when called with an empty lang). The AirPollution*,
+// Geocode and ReverseGeocode methods do not use the language and do not
+// report it.
+func WithLanguage(code string) Option {
+	return func(c *Client) {
+		if !supportedLanguages[code] {
+			c.langErr = &LanguageError{Code: code}
+			return
+		}
+		c.langErr = nil
+		c.lang = code
+	}
+}
+
+// WithCache makes the Client read through cache, serving a response from it
+// when younger than ttl, and falling back to a stale entry (with
+// ErrStaleCache) when a request fails and an entry exists at all.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// WithMaxConcurrency sets the number of chunk requests WeatherByIds issues in
+// flight at once. It has no effect on calls that don't need to chunk, i.e.
+// 20 ids or fewer. n must be at least 1.
+func WithMaxConcurrency(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.maxConcurrency = n
+		}
+	}
 }
 
 // NewClient returns a new Client given an API key.
 //
 // Pass an empty string as the key argument to use the client without an API key.
 func NewClient(key string) *Client {
-	return &Client{key, "http://api.openweathermap.org/data/2.5/"}
+	return NewClientWithOptions(key)
 }
 
-func (c *Client) data(url string) (data []byte, err error) {
-	res, err := http.Get(url)
+// NewClientWithOptions returns a new Client given an API key, configured by
+// the given Options. Without any options it behaves exactly like NewClient.
+func NewClientWithOptions(key string, opts ...Option) *Client {
+	c := &Client{
+		key:            key,
+		baseURL:        "http://api.openweathermap.org/data/2.5/",
+		oneCallBaseURL: defaultOneCallBaseURL,
+		geoBaseURL:     defaultGeoBaseURL,
+		httpClient:     &http.Client{},
+		maxConcurrency: defaultMaxConcurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) dataCtx(ctx context.Context, url string) (data []byte, status int, err error) {
+	var key string
+	if c.cache != nil {
+		key = cacheKey(url)
+		if cached, fetchedAt, ok := c.cache.Get(key); ok && time.Since(fetchedAt) < c.cacheTTL {
+			return cached, http.StatusOK, nil
+		}
+	}
+	var res *http.Response
+	for attempt := 0; attempt <= c.retryN; attempt++ {
+		var req *http.Request
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return
+		}
+		res, err = c.httpClient.Do(req)
+		if err == nil {
+			break
+		}
+		if attempt < c.retryN && c.retryBackoff > 0 {
+			time.Sleep(c.retryBackoff)
+		}
+	}
 	if err != nil {
+		if c.cache != nil {
+			if cached, fetchedAt, ok := c.cache.Get(key); ok {
+				return cached, http.StatusOK, &StaleCacheError{FetchedAt: fetchedAt}
+			}
+		}
 		err = errors.New("owm: error while fetching weather data")
 		return
 	}
+	status = res.StatusCode
 	data, err = ioutil.ReadAll(res.Body)
 	res.Body.Close()
 	if err != nil {
 		err = errors.New("owm: error while fetching weather data")
 		return
 	}
+	if c.cache != nil && (status == http.StatusTooManyRequests || status >= http.StatusInternalServerError) {
+		if cached, fetchedAt, ok := c.cache.Get(key); ok {
+			return cached, http.StatusOK, &StaleCacheError{FetchedAt: fetchedAt}
+		}
+	}
+	if c.cache != nil && status == http.StatusOK {
+		c.cache.Set(key, data, time.Now())
+	}
 	return
 }
 
-func (c *Client) weather(url string) (w Weather, err error) {
+func (c *Client) weather(ctx context.Context, url string) (w Weather, err error) {
+	if c.langErr != nil {
+		err = c.langErr
+		return
+	}
+	if c.lang != "" {
+		url += "&lang=" + c.lang
+	}
 	if c.key != "" {
 		url += "&APPID=" + c.key
 	}
-	data, err := c.data(url)
-	if err != nil || strings.Contains(string(data), `"cod":"404"`) {
-		err = errors.New("owm: error while fetching weather data")
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
 		return
 	}
-	err = json.Unmarshal(data, &w)
-	if err != nil {
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &w); jerr != nil {
 		err = errors.New("owm: error while decoding weather data")
 		return
 	}
+	err = ferr
 	return
 }
 
-func (c *Client) weatherSet(url string) (ws weatherSet, err error) {
+func (c *Client) weatherSet(ctx context.Context, url string) (ws weatherSet, err error) {
+	if c.langErr != nil {
+		err = c.langErr
+		return
+	}
+	if c.lang != "" {
+		url += "&lang=" + c.lang
+	}
 	if c.key != "" {
 		url += "&APPID=" + c.key
 	}
-	data, err := c.data(url)
-	if err != nil || strings.Contains(string(data), `"cod":"404"`) {
-		err = errors.New("owm: error while fetching weather data")
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
 		return
 	}
-	err = json.Unmarshal(data, &ws)
-	if err != nil {
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &ws); jerr != nil {
 		err = errors.New("owm: error while decoding weather data")
 		return
 	}
+	err = ferr
 	return
 }
 
-func (c *Client) forecast(url string) (f Forecast, err error) {
+func (c *Client) oneCall(ctx context.Context, url string) (oc OneCall, err error) {
 	if c.key != "" {
 		url += "&APPID=" + c.key
 	}
-	data, err := c.data(url)
-	if err != nil || strings.Contains(string(data), `"cod":"404"`) {
-		err = errors.New("owm: error while fetching forecast data")
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
 		return
 	}
-	err = json.Unmarshal(data, &f)
-	if err != nil {
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &oc); jerr != nil {
+		err = errors.New("owm: error while decoding one call data")
+		return
+	}
+	err = ferr
+	return
+}
+
+func (c *Client) airPollution(ctx context.Context, url string) (ap AirPollution, err error) {
+	if c.key != "" {
+		url += "&APPID=" + c.key
+	}
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
+		return
+	}
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &ap); jerr != nil {
+		err = errors.New("owm: error while decoding air pollution data")
+		return
+	}
+	err = ferr
+	return
+}
+
+func (c *Client) geocode(ctx context.Context, url string) (locs []Location, err error) {
+	if c.key != "" {
+		url += "&APPID=" + c.key
+	}
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
+		return
+	}
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &locs); jerr != nil {
+		err = errors.New("owm: error while decoding geocoding data")
+		return
+	}
+	err = ferr
+	return
+}
+
+func (c *Client) forecast(ctx context.Context, url string) (f Forecast, err error) {
+	if c.langErr != nil {
+		err = c.langErr
+		return
+	}
+	if c.lang != "" {
+		url += "&lang=" + c.lang
+	}
+	if c.key != "" {
+		url += "&APPID=" + c.key
+	}
+	data, status, ferr := c.dataCtx(ctx, url)
+	if ferr != nil && !errors.Is(ferr, ErrStaleCache) {
+		err = ferr
+		return
+	}
+	if status != http.StatusOK {
+		err = newAPIError(status, data)
+		return
+	}
+	if ae := bodyAPIError(status, data); ae != nil {
+		err = ae
+		return
+	}
+	if jerr := json.Unmarshal(data, &f); jerr != nil {
 		err = errors.New("owm: error while decoding forecast data")
 		return
 	}
+	err = ferr
 	return
 }
 
@@ -280,10 +1063,16 @@ func (c *Client) forecast(url string) (f Forecast, err error) {
 // An error is returned if there is a problem while fetching weather data from
 // the web API or decoding the weather data.
 func (c *Client) WeatherByName(name string, units string) (w Weather, err error) {
-	w, err = c.weather(c.baseURL +
-		"weather" +
-		"?q=" + name +
-		"&units=" + units)
+	return c.WeatherByNameCtx(context.Background(), name, units)
+}
+
+// WeatherByNameCtx is like WeatherByName but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByNameCtx(ctx context.Context, name string, units string) (w Weather, err error) {
+	w, err = c.weather(ctx, c.baseURL+
+		"weather"+
+		"?q="+name+
+		"&units="+units)
 	return
 }
 
@@ -294,10 +1083,16 @@ func (c *Client) WeatherByName(name string, units string) (w Weather, err error)
 // An error is returned if there is a problem while fetching weather data from
 // the web API or decoding the weather data.
 func (c *Client) WeatherById(id int, units string) (w Weather, err error) {
-	w, err = c.weather(c.baseURL +
-		"weather" +
-		"?id=" + strconv.Itoa(id) +
-		"&units=" + units)
+	return c.WeatherByIdCtx(context.Background(), id, units)
+}
+
+// WeatherByIdCtx is like WeatherById but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByIdCtx(ctx context.Context, id int, units string) (w Weather, err error) {
+	w, err = c.weather(ctx, c.baseURL+
+		"weather"+
+		"?id="+strconv.Itoa(id)+
+		"&units="+units)
 	return
 }
 
@@ -309,11 +1104,17 @@ func (c *Client) WeatherById(id int, units string) (w Weather, err error) {
 // An error is returned if there is a problem while fetching weather data from
 // the web API or decoding the weather data.
 func (c *Client) WeatherByCoord(lat, lon float64, units string) (w Weather, err error) {
-	w, err = c.weather(c.baseURL +
-		"weather" +
-		"?lat=" + strconv.FormatFloat(lat, 'f', 2, 64) +
-		"&lon=" + strconv.FormatFloat(lon, 'f', 2, 64) +
-		"&units=" + units)
+	return c.WeatherByCoordCtx(context.Background(), lat, lon, units)
+}
+
+// WeatherByCoordCtx is like WeatherByCoord but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByCoordCtx(ctx context.Context, lat, lon float64, units string) (w Weather, err error) {
+	w, err = c.weather(ctx, c.baseURL+
+		"weather"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+
+		"&units="+units)
 	return
 }
 
@@ -325,15 +1126,21 @@ func (c *Client) WeatherByCoord(lat, lon float64, units string) (w Weather, err
 // An error is returned if there is a problem while fetching weather data from
 // the web API or decoding the weather data.
 func (c *Client) WeatherByZone(lat1, lon1, lat2, lon2 float64, zoom int, units string) (w []Weather, err error) {
-	ws, err := c.weatherSet(c.baseURL +
-		"box/city" +
-		"?bbox=" +
-		strconv.FormatFloat(lat1, 'f', 2, 64) + "," +
-		strconv.FormatFloat(lon1, 'f', 2, 64) + "," +
-		strconv.FormatFloat(lat2, 'f', 2, 64) + "," +
-		strconv.FormatFloat(lon2, 'f', 2, 64) + "," +
-		strconv.Itoa(zoom) +
-		"&units=" + units)
+	return c.WeatherByZoneCtx(context.Background(), lat1, lon1, lat2, lon2, zoom, units)
+}
+
+// WeatherByZoneCtx is like WeatherByZone but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByZoneCtx(ctx context.Context, lat1, lon1, lat2, lon2 float64, zoom int, units string) (w []Weather, err error) {
+	ws, err := c.weatherSet(ctx, c.baseURL+
+		"box/city"+
+		"?bbox="+
+		strconv.FormatFloat(lat1, 'f', 2, 64)+","+
+		strconv.FormatFloat(lon1, 'f', 2, 64)+","+
+		strconv.FormatFloat(lat2, 'f', 2, 64)+","+
+		strconv.FormatFloat(lon2, 'f', 2, 64)+","+
+		strconv.Itoa(zoom)+
+		"&units="+units)
 	w = ws.Weather
 	return
 }
@@ -346,34 +1153,91 @@ func (c *Client) WeatherByZone(lat1, lon1, lat2, lon2 float64, zoom int, units s
 // An error is returned if there is a problem while fetching weather data from
 // the web API or decoding the weather data.
 func (c *Client) WeatherByRadius(lat, lon, radius float64, units string) (w []Weather, err error) {
-	ws, err := c.weatherSet(c.baseURL +
-		"find" +
-		"?lat=" + strconv.FormatFloat(lat, 'f', 2, 64) + "," +
-		"&lon=" + strconv.FormatFloat(lon, 'f', 2, 64) + "," +
-		"&cnt=" + strconv.FormatFloat(radius, 'f', 2, 64) +
-		"&units=" + units)
+	return c.WeatherByRadiusCtx(context.Background(), lat, lon, radius, units)
+}
+
+// WeatherByRadiusCtx is like WeatherByRadius but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByRadiusCtx(ctx context.Context, lat, lon, radius float64, units string) (w []Weather, err error) {
+	ws, err := c.weatherSet(ctx, c.baseURL+
+		"find"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+","+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+","+
+		"&cnt="+strconv.FormatFloat(radius, 'f', 2, 64)+
+		"&units="+units)
 	w = ws.Weather
 	return
 }
 
-// WeatherByIds decodes the current weather of multiple locations given a slice
-// of city ids and units. It uses the corresponding web API URL to fetch JSON
-// encoded data and returns a []Weather with as much fields decoded fields as
+// WeatherByIds decodes the current weather of multiple locations given a
+// slice of city ids and units. It uses the corresponding web API URL to fetch
+// JSON encoded data and returns a []Weather with as much fields decoded as
 // those available.
 //
-// An error is returned if there is a problem while fetching weather data from
-// the web API or decoding the weather data.
+// OWM's "group" endpoint caps a single request at owmGroupMaxIDs ids, so
+// WeatherByIds splits id into chunks of that size and issues them
+// concurrently, bounded by the Client's MaxConcurrency (see
+// WithMaxConcurrency), before merging the results back in input order. If any
+// chunk fails, WeatherByIds still returns the Weather of every chunk that
+// succeeded, alongside an error joining the individual chunk failures.
 func (c *Client) WeatherByIds(id []int, units string) (w []Weather, err error) {
+	return c.WeatherByIdsCtx(context.Background(), id, units)
+}
+
+// WeatherByIdsCtx is like WeatherByIds but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+// Cancelling ctx aborts every in-flight chunk.
+func (c *Client) WeatherByIdsCtx(ctx context.Context, id []int, units string) (w []Weather, err error) {
+	chunks := chunkIDs(id, owmGroupMaxIDs)
+	results := make([][]Weather, len(chunks))
+	errs := make([]error, len(chunks))
+
+	sem := make(chan struct{}, c.maxConcurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk []int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ws, werr := c.weatherSet(ctx, c.baseURL+
+				"group"+
+				"?id="+idsQuery(chunk)+
+				"&units="+units)
+			results[i] = ws.Weather
+			errs[i] = werr
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		w = append(w, r...)
+	}
+	err = joinErrors(errs)
+	return
+}
+
+// chunkIDs splits id into consecutive slices of at most size elements.
+func chunkIDs(id []int, size int) [][]int {
+	var chunks [][]int
+	for size < len(id) {
+		chunks = append(chunks, id[:size:size])
+		id = id[size:]
+	}
+	if len(id) > 0 {
+		chunks = append(chunks, id)
+	}
+	return chunks
+}
+
+// idsQuery joins id into the comma-separated form the "group" endpoint
+// expects for its id query parameter.
+func idsQuery(id []int) string {
 	var ids string
 	for i := range id {
 		ids += strconv.Itoa(id[i]) + ","
 	}
-	ws, err := c.weatherSet(c.baseURL +
-		"group" +
-		"?id=" + ids[:len(ids)-1] +
-		"&units=" + units)
-	w = ws.Weather
-	return
+	return ids[:len(ids)-1]
 }
 
 // ForecastByName decodes the current forecast of a location given the city
@@ -386,17 +1250,23 @@ func (c *Client) WeatherByIds(id []int, units string) (w []Weather, err error) {
 // An error is returned if there is a problem while fetching forecast data from
 // the web API or decoding the forecast data.
 func (c *Client) ForecastByName(name string, days int, units string) (f Forecast, err error) {
+	return c.ForecastByNameCtx(context.Background(), name, days, units)
+}
+
+// ForecastByNameCtx is like ForecastByName but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) ForecastByNameCtx(ctx context.Context, name string, days int, units string) (f Forecast, err error) {
 	if days > 0 {
-		f, err = c.forecast(c.baseURL +
-			"forecast/daily" +
-			"?q=" + name +
-			"&cnt=" + strconv.Itoa(days) +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast/daily"+
+			"?q="+name+
+			"&cnt="+strconv.Itoa(days)+
+			"&units="+units)
 	} else {
-		f, err = c.forecast(c.baseURL +
-			"forecast" +
-			"?q=" + name +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast"+
+			"?q="+name+
+			"&units="+units)
 	}
 	return
 }
@@ -411,17 +1281,23 @@ func (c *Client) ForecastByName(name string, days int, units string) (f Forecast
 // An error is returned if there is a problem while fetching forecast data from
 // the web API or decoding the forecast data.
 func (c *Client) ForecastById(id, days int, units string) (f Forecast, err error) {
+	return c.ForecastByIdCtx(context.Background(), id, days, units)
+}
+
+// ForecastByIdCtx is like ForecastById but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) ForecastByIdCtx(ctx context.Context, id, days int, units string) (f Forecast, err error) {
 	if days > 0 {
-		f, err = c.forecast(c.baseURL +
-			"forecast/daily" +
-			"?id=" + strconv.Itoa(id) +
-			"&cnt=" + strconv.Itoa(days) +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast/daily"+
+			"?id="+strconv.Itoa(id)+
+			"&cnt="+strconv.Itoa(days)+
+			"&units="+units)
 	} else {
-		f, err = c.forecast(c.baseURL +
-			"forecast" +
-			"?id=" + strconv.Itoa(id) +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast"+
+			"?id="+strconv.Itoa(id)+
+			"&units="+units)
 	}
 	return
 }
@@ -436,19 +1312,239 @@ func (c *Client) ForecastById(id, days int, units string) (f Forecast, err error
 // An error is returned if there is a problem while fetching forecast data from
 // the web API or decoding the forecast data.
 func (c *Client) ForecastByCoord(lat, lon float64, days int, units string) (f Forecast, err error) {
+	return c.ForecastByCoordCtx(context.Background(), lat, lon, days, units)
+}
+
+// ForecastByCoordCtx is like ForecastByCoord but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) ForecastByCoordCtx(ctx context.Context, lat, lon float64, days int, units string) (f Forecast, err error) {
 	if days > 0 {
-		f, err = c.forecast(c.baseURL +
-			"forecast/daily" +
-			"?lat=" + strconv.FormatFloat(lat, 'f', 2, 64) +
-			"&lon=" + strconv.FormatFloat(lon, 'f', 2, 64) +
-			"&cnt=" + strconv.Itoa(days) +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast/daily"+
+			"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+			"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+
+			"&cnt="+strconv.Itoa(days)+
+			"&units="+units)
 	} else {
-		f, err = c.forecast(c.baseURL +
-			"forecast" +
-			"?lat=" + strconv.FormatFloat(lat, 'f', 2, 64) +
-			"&lon=" + strconv.FormatFloat(lon, 'f', 2, 64) +
-			"&units=" + units)
+		f, err = c.forecast(ctx, c.baseURL+
+			"forecast"+
+			"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+			"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+
+			"&units="+units)
+	}
+	return
+}
+
+// defaultOneCallBaseURL is the default base URL of the One Call API, which
+// lives under a different API version than the rest of the endpoints. It can
+// be overridden with WithOneCallBaseURL.
+const defaultOneCallBaseURL = "http://api.openweathermap.org/data/3.0/"
+
+// defaultGeoBaseURL is the default base URL of the Geocoding API, which lives
+// under its own path rather than under the data API's version. It can be
+// overridden with WithGeoBaseURL.
+const defaultGeoBaseURL = "http://api.openweathermap.org/geo/1.0/"
+
+// OneCall decodes the current weather, minutely precipitation forecast,
+// hourly forecast, daily forecast and any active weather alerts of a
+// location given its coordinates, units and language, in a single web API
+// call. Pass any of "current", "minutely", "hourly", "daily" or "alerts" as
+// exclude to leave the corresponding section out of the response. It uses
+// the corresponding web API URL to fetch JSON encoded data and returns a
+// OneCall with as much fields decoded as those available.
+//
+// An error is returned if there is a problem while fetching one call data
+// from the web API or decoding the one call data.
+func (c *Client) OneCall(lat, lon float64, units, lang string, exclude ...string) (oc OneCall, err error) {
+	return c.OneCallCtx(context.Background(), lat, lon, units, lang, exclude...)
+}
+
+// OneCallCtx is like OneCall but carries a caller-supplied context.Context,
+// so the request can be cancelled or bound to a deadline.
+func (c *Client) OneCallCtx(ctx context.Context, lat, lon float64, units, lang string, exclude ...string) (oc OneCall, err error) {
+	if lang == "" {
+		if c.langErr != nil {
+			err = c.langErr
+			return
+		}
+		lang = c.lang
+	} else if !supportedLanguages[lang] {
+		err = &LanguageError{Code: lang}
+		return
 	}
+	url := c.oneCallBaseURL +
+		"onecall" +
+		"?lat=" + strconv.FormatFloat(lat, 'f', 2, 64) +
+		"&lon=" + strconv.FormatFloat(lon, 'f', 2, 64) +
+		"&units=" + units
+	if lang != "" {
+		url += "&lang=" + lang
+	}
+	if len(exclude) > 0 {
+		url += "&exclude=" + strings.Join(exclude, ",")
+	}
+	oc, err = c.oneCall(ctx, url)
 	return
 }
+
+// AirPollution decodes the current air quality of a location given its
+// coordinates. It uses the corresponding web API URL to fetch JSON encoded
+// data and returns an AirPollution with as much fields decoded as those
+// available.
+//
+// An error is returned if there is a problem while fetching air pollution
+// data from the web API or decoding the air pollution data.
+func (c *Client) AirPollution(lat, lon float64) (ap AirPollution, err error) {
+	return c.AirPollutionCtx(context.Background(), lat, lon)
+}
+
+// AirPollutionCtx is like AirPollution but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) AirPollutionCtx(ctx context.Context, lat, lon float64) (ap AirPollution, err error) {
+	ap, err = c.airPollution(ctx, c.baseURL+
+		"air_pollution"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64))
+	return
+}
+
+// AirPollutionForecast decodes the air quality forecast of a location given
+// its coordinates. It uses the corresponding web API URL to fetch JSON
+// encoded data and returns an AirPollution with as much fields decoded as
+// those available.
+//
+// An error is returned if there is a problem while fetching air pollution
+// data from the web API or decoding the air pollution data.
+func (c *Client) AirPollutionForecast(lat, lon float64) (ap AirPollution, err error) {
+	return c.AirPollutionForecastCtx(context.Background(), lat, lon)
+}
+
+// AirPollutionForecastCtx is like AirPollutionForecast but carries a
+// caller-supplied context.Context, so the request can be cancelled or bound
+// to a deadline.
+func (c *Client) AirPollutionForecastCtx(ctx context.Context, lat, lon float64) (ap AirPollution, err error) {
+	ap, err = c.airPollution(ctx, c.baseURL+
+		"air_pollution/forecast"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64))
+	return
+}
+
+// AirPollutionHistory decodes the historical air quality of a location given
+// its coordinates and a start and end time. It uses the corresponding web API
+// URL to fetch JSON encoded data and returns an AirPollution with as much
+// fields decoded as those available.
+//
+// An error is returned if there is a problem while fetching air pollution
+// data from the web API or decoding the air pollution data.
+func (c *Client) AirPollutionHistory(lat, lon float64, start, end time.Time) (ap AirPollution, err error) {
+	return c.AirPollutionHistoryCtx(context.Background(), lat, lon, start, end)
+}
+
+// AirPollutionHistoryCtx is like AirPollutionHistory but carries a
+// caller-supplied context.Context, so the request can be cancelled or bound
+// to a deadline.
+func (c *Client) AirPollutionHistoryCtx(ctx context.Context, lat, lon float64, start, end time.Time) (ap AirPollution, err error) {
+	ap, err = c.airPollution(ctx, c.baseURL+
+		"air_pollution/history"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+
+		"&start="+strconv.FormatInt(start.Unix(), 10)+
+		"&end="+strconv.FormatInt(end.Unix(), 10))
+	return
+}
+
+// Geocode resolves name, a city name optionally followed by a comma
+// separated state and country code (e.g. "London,GB"), to up to limit
+// matching Locations. It uses the corresponding web API URL to fetch JSON
+// encoded data and returns a []Location with as much fields decoded as those
+// available.
+//
+// An error is returned if there is a problem while fetching geocoding data
+// from the web API or decoding the geocoding data.
+func (c *Client) Geocode(name string, limit int) (locs []Location, err error) {
+	return c.GeocodeCtx(context.Background(), name, limit)
+}
+
+// GeocodeCtx is like Geocode but carries a caller-supplied context.Context,
+// so the request can be cancelled or bound to a deadline.
+func (c *Client) GeocodeCtx(ctx context.Context, name string, limit int) (locs []Location, err error) {
+	locs, err = c.geocode(ctx, c.geoBaseURL+
+		"direct"+
+		"?q="+name+
+		"&limit="+strconv.Itoa(limit))
+	return
+}
+
+// ReverseGeocode resolves coordinates to up to limit matching Locations. It
+// uses the corresponding web API URL to fetch JSON encoded data and returns a
+// []Location with as much fields decoded as those available.
+//
+// An error is returned if there is a problem while fetching geocoding data
+// from the web API or decoding the geocoding data.
+func (c *Client) ReverseGeocode(lat, lon float64, limit int) (locs []Location, err error) {
+	return c.ReverseGeocodeCtx(context.Background(), lat, lon, limit)
+}
+
+// ReverseGeocodeCtx is like ReverseGeocode but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) ReverseGeocodeCtx(ctx context.Context, lat, lon float64, limit int) (locs []Location, err error) {
+	locs, err = c.geocode(ctx, c.geoBaseURL+
+		"reverse"+
+		"?lat="+strconv.FormatFloat(lat, 'f', 2, 64)+
+		"&lon="+strconv.FormatFloat(lon, 'f', 2, 64)+
+		"&limit="+strconv.Itoa(limit))
+	return
+}
+
+// WeatherByPlace decodes the current weather of a location given a free-form
+// place query and units. It geocodes query to coordinates via Geocode and
+// delegates to WeatherByCoord, so callers no longer depend on OWM's
+// deprecated city name lookup.
+//
+// An error is returned if there is a problem while geocoding the place,
+// fetching weather data from the web API, or decoding the weather data.
+// ErrLocationNotFound is returned if query does not resolve to any location.
+func (c *Client) WeatherByPlace(query string, units string) (w Weather, err error) {
+	return c.WeatherByPlaceCtx(context.Background(), query, units)
+}
+
+// WeatherByPlaceCtx is like WeatherByPlace but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) WeatherByPlaceCtx(ctx context.Context, query string, units string) (w Weather, err error) {
+	locs, err := c.GeocodeCtx(ctx, query, 1)
+	if err != nil {
+		return
+	}
+	if len(locs) == 0 {
+		err = ErrLocationNotFound
+		return
+	}
+	return c.WeatherByCoordCtx(ctx, locs[0].Lat, locs[0].Lon, units)
+}
+
+// ForecastByPlace decodes the forecast of a location given a free-form place
+// query, days and units. It geocodes query to coordinates via Geocode and
+// delegates to ForecastByCoord, so callers no longer depend on OWM's
+// deprecated city name lookup.
+//
+// An error is returned if there is a problem while geocoding the place,
+// fetching forecast data from the web API, or decoding the forecast data.
+// ErrLocationNotFound is returned if query does not resolve to any location.
+func (c *Client) ForecastByPlace(query string, days int, units string) (f Forecast, err error) {
+	return c.ForecastByPlaceCtx(context.Background(), query, days, units)
+}
+
+// ForecastByPlaceCtx is like ForecastByPlace but carries a caller-supplied
+// context.Context, so the request can be cancelled or bound to a deadline.
+func (c *Client) ForecastByPlaceCtx(ctx context.Context, query string, days int, units string) (f Forecast, err error) {
+	locs, err := c.GeocodeCtx(ctx, query, 1)
+	if err != nil {
+		return
+	}
+	if len(locs) == 0 {
+		err = ErrLocationNotFound
+		return
+	}
+	return c.ForecastByCoordCtx(ctx, locs[0].Lat, locs[0].Lon, days, units)
+}